@@ -0,0 +1,82 @@
+package saia
+
+import (
+	"context"
+	"encoding/xml"
+
+	"github.com/pkg/errors"
+)
+
+//bill of lading submission api url and SOAPAction
+const (
+	bolURL        = "http://www.saiasecure.com/webservice/bol/xml.aspx"
+	bolSOAPAction = "http://www.SaiaSecure.com/WebService/CreateBOL"
+)
+
+//BOLRequest is the data needed to submit a bill of lading
+type BOLRequest struct {
+	XMLName xml.Name `xml:"CreateBOL"`
+
+	//required
+	UserID        string //saia secure
+	Password      string //saia secure
+	TestMode      string //Y or N
+	AccountNumber string //shipper's saia account number
+
+	ShipperName    string
+	ShipperStreet  string
+	ShipperCity    string
+	ShipperState   string //two character code
+	ShipperZipcode string
+
+	ConsigneeName    string
+	ConsigneeStreet  string
+	ConsigneeCity    string
+	ConsigneeState   string //two character code
+	ConsigneeZipcode string
+
+	Items []Item `xml:"Details>DetailItem"` //shipment details
+
+	//optional
+	SpecialInstructions string
+}
+
+//BOLResponse is data returned from a bill of lading submission
+//handles successful and errors
+type BOLResponse struct {
+	Code      string
+	Fault     string //S = server, C = client
+	Message   string
+	TestMode  string //Y or N
+	BOLNumber string
+	ProNumber string
+}
+
+//CreateBOL submits a bill of lading for a shipment
+func (c *Client) CreateBOL(ctx context.Context, req *BOLRequest) (resp BOLResponse, err error) {
+	req.UserID = c.UserID
+	req.Password = c.Password
+	req.AccountNumber = c.AccountNumber
+
+	//dry run mode always uses test mode, regardless of c.TestMode, so the
+	//call can't accidentally create a real bol
+	if c.DryRun {
+		req.TestMode = "Y"
+	} else {
+		req.TestMode = c.TestMode
+	}
+
+	err = c.do(ctx, bolURL, bolSOAPAction, req, &resp)
+	if err != nil {
+		err = errors.Wrap(err, "saia.CreateBOL - could not create bol")
+		return
+	}
+
+	if resp.Code != "" || resp.BOLNumber == "" {
+		err = errors.New("saia.CreateBOL - bol creation failed")
+		err = errors.Wrap(err, resp.Message)
+		return
+	}
+
+	return
+}
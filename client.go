@@ -0,0 +1,145 @@
+package saia
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/coreymgilmore/saia/soap"
+)
+
+//Client holds the credentials and connection settings shared across calls
+//to the SAIA Secure web service.  Using a Client instead of re-entering
+//UserID/Password/AccountNumber on every request also means the test/production
+//mode, timeout, and dry-run flag are no longer package-level globals, so two
+//goroutines using different Clients can't race each other.
+type Client struct {
+	UserID        string //saia secure
+	Password      string //saia secure
+	AccountNumber string //shipper's saia account number
+
+	TestMode string //Y or N, set via WithProductionMode()
+	DryRun   bool   //forces TestMode to Y on every operation that submits one, regardless of TestMode; set via WithDryRun()
+	Timeout  time.Duration
+
+	//HTTPClient is used to make every call.  Override it to plug in mTLS,
+	//a proxy, or any other transport an enterprise deployment needs.  Left
+	//nil, a client with Timeout is used.
+	HTTPClient *http.Client
+
+	//Logger receives request/response dumps when Dump is true.  Left nil,
+	//nothing is logged.
+	Logger Logger
+	Dump   bool
+}
+
+//Option configures a Client built by NewClient()
+type Option func(*Client)
+
+//WithCredentials sets the saia secure account a Client makes calls against
+func WithCredentials(userID, password, accountNumber string) Option {
+	return func(c *Client) {
+		c.UserID = userID
+		c.Password = password
+		c.AccountNumber = accountNumber
+	}
+}
+
+//WithProductionMode chooses the production url for use
+func WithProductionMode(yes bool) Option {
+	return func(c *Client) {
+		if yes {
+			c.TestMode = "N"
+		}
+	}
+}
+
+//WithDryRun forces TestMode to Y regardless of WithProductionMode(), on every
+//operation that submits one (RequestPickup, RateQuote, CreateBOL), so calls
+//can be exercised end to end (including SAIA's own validation) without
+//risking a real pickup, quote, or bol
+func WithDryRun(yes bool) Option {
+	return func(c *Client) {
+		c.DryRun = yes
+	}
+}
+
+//WithTimeout sets how long to wait for a reply from SAIA.  Ignored if
+//WithHTTPClient() is also used.
+func WithTimeout(seconds time.Duration) Option {
+	return func(c *Client) {
+		c.Timeout = time.Duration(seconds * time.Second)
+	}
+}
+
+//WithHTTPClient overrides the http.Client used for every call, e.g. to plug
+//in mTLS or a proxy
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.HTTPClient = httpClient
+	}
+}
+
+//WithLogger routes request/response dumps (see WithDump()) to l instead of
+//discarding them.  l can wrap zap, logrus, zerolog, or anything else that can
+//provide a Printf-style method.
+func WithLogger(l Logger) Option {
+	return func(c *Client) {
+		c.Logger = l
+	}
+}
+
+//WithDump turns on raw request/response capture through the configured Logger
+func WithDump(yes bool) Option {
+	return func(c *Client) {
+		c.Dump = yes
+	}
+}
+
+//NewClient builds a Client for making calls against the SAIA Secure web service.
+//Defaults to test mode with a 10 second timeout and no credentials; use
+//WithCredentials() and the other With* options to configure it.
+func NewClient(opts ...Option) *Client {
+	c := &Client{
+		TestMode: "Y",
+		Timeout:  time.Duration(10 * time.Second),
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+//httpClient returns the caller-provided HTTPClient, falling back to one
+//built from Timeout.
+func (c *Client) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+
+	return &http.Client{Timeout: c.Timeout}
+}
+
+//logf dumps a request/response through Logger, if Dump is on and a Logger is set
+func (c *Client) logf(format string, args ...interface{}) {
+	if c.Dump && c.Logger != nil {
+		c.Logger.Printf(format, args...)
+	}
+}
+
+//do wraps reqData in a SOAP envelope, posts it to url, and unmarshals the
+//response into respData.  This is shared by every operation on Client so the
+//transport handling and request/response dumping only need to live in one
+//place; errors are returned as is and each operation wraps its own.
+func (c *Client) do(ctx context.Context, url string, soapAction string, reqData interface{}, respData interface{}) (err error) {
+	c.logf("saia.Client.do - request: %+v", reqData)
+
+	soapClient := soap.NewClient(url, c.httpClient())
+	err = soapClient.Call(ctx, soapAction, reqData, respData)
+
+	c.logf("saia.Client.do - response: %+v, err: %v", respData, err)
+
+	return
+}
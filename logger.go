@@ -0,0 +1,8 @@
+package saia
+
+//Logger is the interface Client uses for optional request/response dumps
+//(see Client.Dump).  Wrap your existing zap, logrus, zerolog, etc. logger in
+//a type that implements this to route dumps wherever your app already logs to.
+type Logger interface {
+	Printf(format string, args ...interface{})
+}
@@ -0,0 +1,108 @@
+package saia
+
+import (
+	"context"
+	"encoding/xml"
+
+	"github.com/pkg/errors"
+)
+
+//pickup inquiry/cancellation api urls and SOAPActions
+const (
+	pickupInquiryURL        = "http://www.saiasecure.com/webservice/pickup/inquiry/xml.aspx"
+	pickupInquirySOAPAction = "http://www.SaiaSecure.com/WebService/PickupInquiry"
+
+	pickupCancelURL        = "http://www.saiasecure.com/webservice/pickup/cancel/xml.aspx"
+	pickupCancelSOAPAction = "http://www.SaiaSecure.com/WebService/CancelPickup"
+)
+
+//PickupInquiryRequest is the data needed to look up a previously requested pickup
+type PickupInquiryRequest struct {
+	XMLName xml.Name `xml:"PickupInquiry"`
+
+	UserID        string //saia secure
+	Password      string //saia secure
+	AccountNumber string //shipper's saia account number
+	PickupNumber  string //pickup confirmation number
+}
+
+//PickupInquiryResponse is data returned from a pickup inquiry
+//handles successful and errors
+type PickupInquiryResponse struct {
+	Code         string
+	Fault        string //S = server, C = client
+	Message      string
+	PickupNumber string
+	Status       string //current status of the pickup
+	PickupDate   string
+	ReadyTime    string
+	CloseTime    string
+}
+
+//CancelPickupRequest is the data needed to cancel a previously requested pickup
+type CancelPickupRequest struct {
+	XMLName xml.Name `xml:"CancelPickup"`
+
+	UserID        string //saia secure
+	Password      string //saia secure
+	AccountNumber string //shipper's saia account number
+	PickupNumber  string //pickup confirmation number
+}
+
+//CancelPickupResponse is data returned from a pickup cancellation
+//handles successful and errors
+type CancelPickupResponse struct {
+	Code         string
+	Fault        string //S = server, C = client
+	Message      string
+	PickupNumber string
+	Cancelled    string //Y or N
+}
+
+//PickupInquiry looks up the status of a previously requested pickup
+func (c *Client) PickupInquiry(ctx context.Context, pickupNumber string) (resp PickupInquiryResponse, err error) {
+	req := PickupInquiryRequest{
+		UserID:        c.UserID,
+		Password:      c.Password,
+		AccountNumber: c.AccountNumber,
+		PickupNumber:  pickupNumber,
+	}
+
+	err = c.do(ctx, pickupInquiryURL, pickupInquirySOAPAction, &req, &resp)
+	if err != nil {
+		err = errors.Wrap(err, "saia.PickupInquiry - could not look up pickup")
+		return
+	}
+
+	if resp.Code != "" {
+		err = errors.New("saia.PickupInquiry - pickup inquiry failed")
+		err = errors.Wrap(err, resp.Message)
+		return
+	}
+
+	return
+}
+
+//CancelPickup cancels a previously requested pickup
+func (c *Client) CancelPickup(ctx context.Context, pickupNumber string) (resp CancelPickupResponse, err error) {
+	req := CancelPickupRequest{
+		UserID:        c.UserID,
+		Password:      c.Password,
+		AccountNumber: c.AccountNumber,
+		PickupNumber:  pickupNumber,
+	}
+
+	err = c.do(ctx, pickupCancelURL, pickupCancelSOAPAction, &req, &resp)
+	if err != nil {
+		err = errors.Wrap(err, "saia.CancelPickup - could not cancel pickup")
+		return
+	}
+
+	if resp.Code != "" || resp.Cancelled != "Y" {
+		err = errors.New("saia.CancelPickup - pickup cancellation failed")
+		err = errors.Wrap(err, resp.Message)
+		return
+	}
+
+	return
+}
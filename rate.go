@@ -0,0 +1,75 @@
+package saia
+
+import (
+	"context"
+	"encoding/xml"
+
+	"github.com/pkg/errors"
+)
+
+//rate quote api url and SOAPAction
+const (
+	rateQuoteURL        = "http://www.saiasecure.com/webservice/rate/xml.aspx"
+	rateQuoteSOAPAction = "http://www.SaiaSecure.com/WebService/RateQuote"
+)
+
+//RateQuoteRequest is the data needed to get a freight rate quote
+type RateQuoteRequest struct {
+	XMLName xml.Name `xml:"RateQuote"`
+
+	//required
+	UserID             string //saia secure
+	Password           string //saia secure
+	TestMode           string //Y or N
+	AccountNumber      string //shipper's saia account number
+	OriginZipcode      string
+	DestinationZipcode string
+	Pieces             uint
+	Package            string  //two character code, SK = skids
+	Weight             float64 //lbs
+
+	//optional
+	DestinationCountry string //US, CN, MX
+	Freezable          string //Y or N
+}
+
+//RateQuoteResponse is data returned from a rate quote request
+//handles successful and errors
+type RateQuoteResponse struct {
+	Code                  string
+	Fault                 string //S = server, C = client
+	Message               string
+	TestMode              string //Y or N
+	TotalCharges          float64
+	TransitDays           uint
+	EstimatedDeliveryDate string
+}
+
+//RateQuote gets a freight rate quote for a shipment
+func (c *Client) RateQuote(ctx context.Context, req *RateQuoteRequest) (resp RateQuoteResponse, err error) {
+	req.UserID = c.UserID
+	req.Password = c.Password
+	req.AccountNumber = c.AccountNumber
+
+	//dry run mode always uses test mode, regardless of c.TestMode, for
+	//consistency with the other operations that take a TestMode
+	if c.DryRun {
+		req.TestMode = "Y"
+	} else {
+		req.TestMode = c.TestMode
+	}
+
+	err = c.do(ctx, rateQuoteURL, rateQuoteSOAPAction, req, &resp)
+	if err != nil {
+		err = errors.Wrap(err, "saia.RateQuote - could not get rate quote")
+		return
+	}
+
+	if resp.Code != "" {
+		err = errors.New("saia.RateQuote - rate quote request failed")
+		err = errors.Wrap(err, resp.Message)
+		return
+	}
+
+	return
+}
@@ -6,39 +6,53 @@ You will need to have a SAIA Secure account and register for access to use this.
 
 Currently this package can perform:
 - pickup requests
+- rate quotes
+- shipment tracking
+- pickup inquiry and cancellation
+- bill of lading (BOL) submission
 
 To create a pickup request:
-- Set test or production mode (SetProductionMode()).
+- Build a Client with NewClient(), passing WithCredentials() and any other options needed.
 - Set shipper information.
 - Set shipment data.
-- Request the pickup (RequestPickup()).
+- Request the pickup (Client.RequestPickup()).
 - Check for any errors.
+
+The package-level SetProductionMode(), SetTimeout(), SetDryRun(), and the
+(*Request).RequestPickup() method are deprecated but kept for backwards
+compatibility; they build a one-off Client under the hood.
 */
 package saia
 
 import (
+	"context"
 	"encoding/xml"
-	"io/ioutil"
-	"log"
-	"net/http"
-	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 )
 
-//api url
-const saiaURL = "http://www.saiasecure.com/webservice/pickup/xml.aspx"
+//api url and SOAPAction
+const (
+	saiaURL        = "http://www.saiasecure.com/webservice/pickup/xml.aspx"
+	saiaSOAPAction = "http://www.SaiaSecure.com/WebService/Pickup"
+)
 
 //test mode
 //this is set as an attribute in the xml request
 //values are either "Y" or "N"
 //this can be updated using the SetProductionMode() func.
+//
+//Deprecated: this is only used by the deprecated package-level RequestPickup().
+//Set Client.TestMode (or use WithProductionMode()) instead.
 var testMode = "Y"
 
 //timeout is the default time we should wait for a reply from Ward
 //You may need to adjust this based on how slow connecting to Ward is for you.
 //10 seconds is overly long, but sometimes Ward is very slow.
+//
+//Deprecated: this is only used by the deprecated package-level RequestPickup().
+//Set Client.Timeout (or use WithTimeout()) instead.
 var timeout = time.Duration(10 * time.Second)
 
 //base XML data
@@ -142,6 +156,9 @@ type PickupTerminal struct {
 }
 
 //SetProductionMode chooses the production url for use
+//
+//Deprecated: this only affects the deprecated package-level RequestPickup().
+//Use WithProductionMode() with NewClient() instead.
 func SetProductionMode(yes bool) {
 	if yes {
 		testMode = "N"
@@ -151,65 +168,67 @@ func SetProductionMode(yes bool) {
 
 //SetTimeout updates the timeout value to something the user sets
 //use this to increase the timeout if connecting to saia is really slow
+//
+//Deprecated: this only affects the deprecated package-level RequestPickup().
+//Use WithTimeout() with NewClient() instead.
 func SetTimeout(seconds time.Duration) {
 	timeout = time.Duration(seconds * time.Second)
 	return
 }
 
 //RequestPickup performs the call to the saia API to schedule a pickup
-func (p *Request) RequestPickup() (responseData ResponseData, err error) {
-	//set test mode flag as needed
-	p.TestMode = testMode
-
-	//convert to xml
-	xmlBytes, err := xml.Marshal(p)
-	if err != nil {
-		err = errors.Wrap(err, "saia.RequestPickup - could not marshal xml")
-		return
-	}
-
-	//add the xml header
-	xmlString := xml.Header + string(xmlBytes)
-	log.Print(xmlString)
-
-	//make the call to the saia API
-	//set a timeout since golang doesn't set one by default and we don't want this to hang forever
-	httpClient := http.Client{
-		Timeout: timeout,
-	}
-	res, err := httpClient.Post(saiaURL, "text/xml", strings.NewReader(xmlString))
-	if err != nil {
-		err = errors.Wrap(err, "saia.RequestPickup - could not make post request")
+func (c *Client) RequestPickup(ctx context.Context, p *Request) (responseData ResponseData, err error) {
+	p.UserID = c.UserID
+	p.Password = c.Password
+	p.AccountNumber = c.AccountNumber
+
+	//run local validation after credentials are copied over, so a bad request
+	//fails without round-tripping to SAIA but a Client with valid credentials
+	//doesn't get rejected just because the caller left them off of p
+	if errs := p.Validate(); len(errs) > 0 {
+		err = errs
 		return
 	}
 
-	//read the response
-	//response should hold success or error data
-	body, err := ioutil.ReadAll(res.Body)
-	defer res.Body.Close()
-	if err != nil {
-		err = errors.Wrap(err, "saia.RequestPickup - could not read response 1")
-		return
+	//dry run mode always uses test mode, regardless of c.TestMode, so the
+	//call can't accidentally create a real pickup
+	if c.DryRun {
+		p.TestMode = "Y"
+	} else {
+		p.TestMode = c.TestMode
 	}
 
-	err = xml.Unmarshal(body, &responseData)
+	err = c.do(ctx, saiaURL, saiaSOAPAction, p, &responseData)
 	if err != nil {
-		err = errors.Wrap(err, "saia.RequestPickup - could not read response 2")
+		err = errors.Wrap(err, "saia.Client.RequestPickup - could not make soap call")
 		return
 	}
 
 	if responseData.Code != "" || responseData.PickupNumber == "" {
-		log.Println("saia.RequestPickup - pickup request failed")
-		log.Printf("%+v", responseData)
-
-		err = errors.New("saia.RequestPickup - pickup request failed")
+		err = errors.New("saia.Client.RequestPickup - pickup request failed")
 		err = errors.Wrap(err, responseData.Message)
 		return
 	}
 
-	log.Printf("%+v", responseData)
-
 	//pickup request successful
 	//response data will have confirmation info
 	return
 }
+
+//RequestPickup performs the call to the saia API to schedule a pickup
+//
+//Deprecated: use (*Client).RequestPickup instead, which doesn't rely on the
+//package-level SetProductionMode()/SetTimeout()/SetDryRun() globals. This
+//keeps the original zero-arg signature (context.Background() is used
+//internally) so existing callers don't break; it builds a one-off Client
+//from those globals and p's own credentials.
+func (p *Request) RequestPickup() (responseData ResponseData, err error) {
+	c := NewClient(
+		WithCredentials(p.UserID, p.Password, p.AccountNumber),
+		WithProductionMode(testMode == "N"),
+		WithDryRun(dryRun),
+	)
+	c.Timeout = timeout //already a Duration, not a count of seconds like WithTimeout() takes
+
+	return c.RequestPickup(context.Background(), p)
+}
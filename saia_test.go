@@ -0,0 +1,296 @@
+package saia
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+//redirectTransport rewrites the scheme/host of every outgoing request to
+//point at a test server while leaving the path untouched, so a Client can be
+//exercised against its real, hardcoded operation URLs without changing them.
+type redirectTransport struct {
+	target *url.URL
+}
+
+func (t *redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req.URL.Scheme = t.target.Scheme
+	req.URL.Host = t.target.Host
+
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+//newTestClient builds a Client whose calls are all redirected to server
+func newTestClient(t *testing.T, server *httptest.Server) *Client {
+	t.Helper()
+
+	target, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("could not parse test server url: %v", err)
+	}
+
+	return NewClient(
+		WithCredentials("user", "pass", "123456"),
+		WithHTTPClient(&http.Client{Transport: &redirectTransport{target: target}}),
+	)
+}
+
+//soapHandler serves a canned SOAP response keyed by request path, the same
+//way a real carrier endpoint would route by url
+func soapHandler(byPath map[string]string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, ok := byPath[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/xml")
+		w.Write([]byte(body))
+	}
+}
+
+func soapEnvelope(content string) string {
+	return `<?xml version="1.0" encoding="utf-8"?>
+<soap:Envelope xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:xsd="http://www.w3.org/2001/XMLSchema" xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/">
+  <soap:Body>` + content + `</soap:Body>
+</soap:Envelope>`
+}
+
+func TestClientRequestPickup(t *testing.T) {
+	server := httptest.NewServer(soapHandler(map[string]string{
+		"/webservice/pickup/xml.aspx": soapEnvelope(`
+    <CreateResponse>
+      <Code></Code>
+      <PickupNumber>PU123</PickupNumber>
+      <TotalPieces>2</TotalPieces>
+      <PickupTerminal><Name>Lafayette</Name></PickupTerminal>
+    </CreateResponse>`),
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server)
+
+	resp, err := c.RequestPickup(context.Background(), &Request{
+		Item: Item{
+			DestinationZipcode: "70508",
+			Pieces:             2,
+			Package:            "SK",
+			Weight:             100,
+		},
+	})
+	if err != nil {
+		t.Fatalf("RequestPickup returned an error: %v", err)
+	}
+
+	if resp.PickupNumber != "PU123" {
+		t.Fatalf("expected PickupNumber %q, got %q", "PU123", resp.PickupNumber)
+	}
+	if resp.PickupTerminal.Name != "Lafayette" {
+		t.Fatalf("expected PickupTerminal.Name %q, got %q", "Lafayette", resp.PickupTerminal.Name)
+	}
+}
+
+func TestClientRequestPickupCopiesCredentialsBeforeValidating(t *testing.T) {
+	server := httptest.NewServer(soapHandler(map[string]string{
+		"/webservice/pickup/xml.aspx": soapEnvelope(`
+    <CreateResponse>
+      <Code></Code>
+      <PickupNumber>PU123</PickupNumber>
+    </CreateResponse>`),
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server)
+
+	//UserID/Password/AccountNumber are deliberately left unset on the
+	//Request - they should come from the Client, the same way the package
+	//doc's usage recipe describes
+	_, err := c.RequestPickup(context.Background(), &Request{
+		Item: Item{
+			DestinationZipcode: "70508",
+			Pieces:             2,
+			Package:            "SK",
+			Weight:             100,
+		},
+	})
+	if err != nil {
+		t.Fatalf("RequestPickup returned an error: %v", err)
+	}
+}
+
+func TestClientRateQuote(t *testing.T) {
+	server := httptest.NewServer(soapHandler(map[string]string{
+		"/webservice/rate/xml.aspx": soapEnvelope(`
+    <RateQuoteResponse>
+      <Code></Code>
+      <TotalCharges>123.45</TotalCharges>
+      <TransitDays>2</TransitDays>
+    </RateQuoteResponse>`),
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server)
+
+	resp, err := c.RateQuote(context.Background(), &RateQuoteRequest{
+		OriginZipcode:      "70508",
+		DestinationZipcode: "30301",
+		Pieces:             2,
+		Package:            "SK",
+		Weight:             100,
+	})
+	if err != nil {
+		t.Fatalf("RateQuote returned an error: %v", err)
+	}
+
+	if resp.TotalCharges != 123.45 {
+		t.Fatalf("expected TotalCharges %v, got %v", 123.45, resp.TotalCharges)
+	}
+}
+
+func TestClientTrackShipment(t *testing.T) {
+	server := httptest.NewServer(soapHandler(map[string]string{
+		"/webservice/tracing/xml.aspx": soapEnvelope(`
+    <TrackResponse>
+      <Code></Code>
+      <ProNumber>PRO123</ProNumber>
+      <Status>Delivered</Status>
+    </TrackResponse>`),
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server)
+
+	resp, err := c.TrackShipment(context.Background(), "PRO123")
+	if err != nil {
+		t.Fatalf("TrackShipment returned an error: %v", err)
+	}
+
+	if resp.Status != "Delivered" {
+		t.Fatalf("expected Status %q, got %q", "Delivered", resp.Status)
+	}
+}
+
+func TestClientPickupInquiry(t *testing.T) {
+	server := httptest.NewServer(soapHandler(map[string]string{
+		"/webservice/pickup/inquiry/xml.aspx": soapEnvelope(`
+    <PickupInquiryResponse>
+      <Code></Code>
+      <PickupNumber>PU123</PickupNumber>
+      <Status>Scheduled</Status>
+    </PickupInquiryResponse>`),
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server)
+
+	resp, err := c.PickupInquiry(context.Background(), "PU123")
+	if err != nil {
+		t.Fatalf("PickupInquiry returned an error: %v", err)
+	}
+
+	if resp.Status != "Scheduled" {
+		t.Fatalf("expected Status %q, got %q", "Scheduled", resp.Status)
+	}
+}
+
+func TestClientCancelPickup(t *testing.T) {
+	server := httptest.NewServer(soapHandler(map[string]string{
+		"/webservice/pickup/cancel/xml.aspx": soapEnvelope(`
+    <CancelPickupResponse>
+      <Code></Code>
+      <PickupNumber>PU123</PickupNumber>
+      <Cancelled>Y</Cancelled>
+    </CancelPickupResponse>`),
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server)
+
+	resp, err := c.CancelPickup(context.Background(), "PU123")
+	if err != nil {
+		t.Fatalf("CancelPickup returned an error: %v", err)
+	}
+
+	if resp.Cancelled != "Y" {
+		t.Fatalf("expected Cancelled %q, got %q", "Y", resp.Cancelled)
+	}
+}
+
+func TestClientCreateBOL(t *testing.T) {
+	server := httptest.NewServer(soapHandler(map[string]string{
+		"/webservice/bol/xml.aspx": soapEnvelope(`
+    <CreateBOLResponse>
+      <Code></Code>
+      <BOLNumber>BOL123</BOLNumber>
+      <ProNumber>PRO123</ProNumber>
+    </CreateBOLResponse>`),
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server)
+
+	resp, err := c.CreateBOL(context.Background(), &BOLRequest{
+		ShipperName:      "Acme",
+		ConsigneeName:    "Widgets Inc",
+		ConsigneeZipcode: "30301",
+	})
+	if err != nil {
+		t.Fatalf("CreateBOL returned an error: %v", err)
+	}
+
+	if resp.BOLNumber != "BOL123" {
+		t.Fatalf("expected BOLNumber %q, got %q", "BOL123", resp.BOLNumber)
+	}
+}
+
+func TestClientCreateBOLHonorsDryRun(t *testing.T) {
+	var gotTestMode string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b := make([]byte, r.ContentLength)
+		r.Body.Read(b)
+		if strings.Contains(string(b), "<TestMode>Y</TestMode>") {
+			gotTestMode = "Y"
+		} else {
+			gotTestMode = "N"
+		}
+
+		w.Header().Set("Content-Type", "text/xml")
+		w.Write([]byte(soapEnvelope(`
+    <CreateBOLResponse>
+      <Code></Code>
+      <BOLNumber>BOL123</BOLNumber>
+      <ProNumber>PRO123</ProNumber>
+    </CreateBOLResponse>`)))
+	}))
+	defer server.Close()
+
+	target, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("could not parse test server url: %v", err)
+	}
+
+	c := NewClient(
+		WithCredentials("user", "pass", "123456"),
+		WithProductionMode(true),
+		WithDryRun(true),
+		WithHTTPClient(&http.Client{Transport: &redirectTransport{target: target}}),
+	)
+
+	_, err = c.CreateBOL(context.Background(), &BOLRequest{
+		ShipperName:      "Acme",
+		ConsigneeName:    "Widgets Inc",
+		ConsigneeZipcode: "30301",
+	})
+	if err != nil {
+		t.Fatalf("CreateBOL returned an error: %v", err)
+	}
+
+	if gotTestMode != "Y" {
+		t.Fatalf("expected TestMode Y despite WithProductionMode(true), got %q", gotTestMode)
+	}
+}
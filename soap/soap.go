@@ -0,0 +1,158 @@
+/*Package soap provides a minimal SOAP 1.1 envelope and a transport for calling
+carrier web services that actually speak SOAP.  Every SAIA operation,
+including the pickup endpoint that used to hand-roll a non-SOAP body, marshals
+through here.
+*/
+package soap
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+//standard SOAP 1.1 namespaces
+const (
+	NSXSI  = "http://www.w3.org/2001/XMLSchema-instance"
+	NSXSD  = "http://www.w3.org/2001/XMLSchema"
+	NSSoap = "http://schemas.xmlsoap.org/soap/envelope/"
+)
+
+//SOAPEnvelope is the outermost soap:Envelope element.  The Header/Body fields
+//are left untagged on purpose: tagging them with a literal "soap:Header"/
+//"soap:Body" name would conflict with, and not match the resolved namespace
+//of, the XMLName tag each of those types already carries.
+type SOAPEnvelope struct {
+	XMLName xml.Name `xml:"http://schemas.xmlsoap.org/soap/envelope/ Envelope"`
+
+	XsiAttr  string `xml:"xmlns:xsi,attr"`
+	XsdAttr  string `xml:"xmlns:xsd,attr"`
+	SoapAttr string `xml:"xmlns:soap,attr"`
+
+	Header *SOAPHeader
+	Body   SOAPBody
+}
+
+//SOAPHeader is the optional soap:Header element.  Nothing in this package
+//populates it yet (Call always sends an envelope with a nil Header) - it
+//exists so a future WS-Security or similar requirement can decode/encode
+//through Content without a wire-format change.
+type SOAPHeader struct {
+	XMLName xml.Name `xml:"http://schemas.xmlsoap.org/soap/envelope/ Header"`
+
+	Content interface{} `xml:",omitempty"`
+}
+
+//SOAPBody is the soap:Body element.  Content is set when marshaling a request;
+//Fault and RawContent are populated when unmarshaling a response.
+type SOAPBody struct {
+	XMLName xml.Name `xml:"http://schemas.xmlsoap.org/soap/envelope/ Body"`
+
+	Content    interface{} `xml:",omitempty"`
+	Fault      *SOAPFault  `xml:"Fault"`
+	RawContent []byte      `xml:",innerxml"`
+}
+
+//SOAPFault is a standard SOAP 1.1 fault.  It implements error so a fault
+//returned from a carrier can be handled like any other Go error instead of
+//being string-matched out of a business response.
+type SOAPFault struct {
+	XMLName xml.Name `xml:"Fault"`
+
+	FaultCode   string `xml:"faultcode"`
+	FaultString string `xml:"faultstring"`
+	FaultActor  string `xml:"faultactor"`
+	Detail      string `xml:"detail"`
+}
+
+func (f *SOAPFault) Error() string {
+	return "soap fault [" + f.FaultCode + "]: " + f.FaultString
+}
+
+//Client makes SOAP calls against a single endpoint url.  HTTPClient is
+//exposed so callers can plug in mTLS, proxies, or other custom transports.
+type Client struct {
+	URL        string
+	HTTPClient *http.Client
+}
+
+//NewClient builds a Client for the given endpoint url.  If httpClient is nil,
+//http.DefaultClient is used.
+func NewClient(url string, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	return &Client{
+		URL:        url,
+		HTTPClient: httpClient,
+	}
+}
+
+//Call wraps reqBody in a SOAP envelope, posts it to the client's url, and
+//unmarshals the response body into respBody.  soapAction is sent as the
+//SOAPAction header when non-empty.  ctx governs cancellation/timeout for the
+//request.  If the server returns a SOAP fault, it is returned as a *SOAPFault
+//instead of being decoded into respBody.
+func (c *Client) Call(ctx context.Context, soapAction string, reqBody interface{}, respBody interface{}) (err error) {
+	envelope := SOAPEnvelope{
+		XsiAttr:  NSXSI,
+		XsdAttr:  NSXSD,
+		SoapAttr: NSSoap,
+		Body:     SOAPBody{Content: reqBody},
+	}
+
+	xmlBytes, err := xml.Marshal(envelope)
+	if err != nil {
+		err = errors.Wrap(err, "soap.Call - could not marshal envelope")
+		return
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.URL, bytes.NewReader(append([]byte(xml.Header), xmlBytes...)))
+	if err != nil {
+		err = errors.Wrap(err, "soap.Call - could not build request")
+		return
+	}
+
+	httpReq.Header.Set("Content-Type", "text/xml; charset=utf-8")
+	if soapAction != "" {
+		httpReq.Header.Set("SOAPAction", soapAction)
+	}
+
+	res, err := c.HTTPClient.Do(httpReq)
+	if err != nil {
+		err = errors.Wrap(err, "soap.Call - could not make post request")
+		return
+	}
+	defer res.Body.Close()
+
+	resBytes, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		err = errors.Wrap(err, "soap.Call - could not read response")
+		return
+	}
+
+	var resEnvelope SOAPEnvelope
+	err = xml.Unmarshal(resBytes, &resEnvelope)
+	if err != nil {
+		err = errors.Wrap(err, "soap.Call - could not unmarshal envelope")
+		return
+	}
+
+	if resEnvelope.Body.Fault != nil {
+		err = resEnvelope.Body.Fault
+		return
+	}
+
+	err = xml.Unmarshal(resEnvelope.Body.RawContent, respBody)
+	if err != nil {
+		err = errors.Wrap(err, "soap.Call - could not unmarshal body")
+		return
+	}
+
+	return
+}
@@ -0,0 +1,89 @@
+package soap
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type testResponseBody struct {
+	XMLName xml.Name `xml:"CreateResponse"`
+	Code    string
+	Message string
+}
+
+//TestCallDecodesRealisticResponse round-trips a canned SOAP response, shaped
+//like a real server reply (soap: prefixed, xmlns declared on the envelope),
+//through Call() to make sure the envelope/body actually decode.
+func TestCallDecodesRealisticResponse(t *testing.T) {
+	const rawResponse = `<?xml version="1.0" encoding="utf-8"?>
+<soap:Envelope xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:xsd="http://www.w3.org/2001/XMLSchema" xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/">
+  <soap:Body>
+    <CreateResponse>
+      <Code></Code>
+      <Message>ok</Message>
+    </CreateResponse>
+  </soap:Body>
+</soap:Envelope>`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+		w.Write([]byte(rawResponse))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, server.Client())
+
+	var resp testResponseBody
+	err := client.Call(context.Background(), "", struct {
+		XMLName xml.Name `xml:"Create"`
+	}{}, &resp)
+	if err != nil {
+		t.Fatalf("Call returned an error: %v", err)
+	}
+
+	if resp.Message != "ok" {
+		t.Fatalf("expected Message %q, got %q", "ok", resp.Message)
+	}
+}
+
+//TestCallDecodesFault makes sure a SOAP fault is returned as a *SOAPFault
+//instead of being decoded into respBody.
+func TestCallDecodesFault(t *testing.T) {
+	const rawResponse = `<?xml version="1.0" encoding="utf-8"?>
+<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/">
+  <soap:Body>
+    <Fault>
+      <faultcode>soap:Client</faultcode>
+      <faultstring>bad request</faultstring>
+    </Fault>
+  </soap:Body>
+</soap:Envelope>`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+		w.Write([]byte(rawResponse))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, server.Client())
+
+	var resp testResponseBody
+	err := client.Call(context.Background(), "", struct {
+		XMLName xml.Name `xml:"Create"`
+	}{}, &resp)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+
+	fault, ok := err.(*SOAPFault)
+	if !ok {
+		t.Fatalf("expected a *SOAPFault, got %T: %v", err, err)
+	}
+
+	if fault.FaultString != "bad request" {
+		t.Fatalf("expected faultstring %q, got %q", "bad request", fault.FaultString)
+	}
+}
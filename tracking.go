@@ -0,0 +1,68 @@
+package saia
+
+import (
+	"context"
+	"encoding/xml"
+
+	"github.com/pkg/errors"
+)
+
+//shipment tracking api url and SOAPAction
+const (
+	trackingURL        = "http://www.saiasecure.com/webservice/tracing/xml.aspx"
+	trackingSOAPAction = "http://www.SaiaSecure.com/WebService/Track"
+)
+
+//TrackingRequest is the data needed to look up a shipment's tracking status
+type TrackingRequest struct {
+	XMLName xml.Name `xml:"Track"`
+
+	UserID        string //saia secure
+	Password      string //saia secure
+	AccountNumber string //shipper's saia account number
+	ProNumber     string //saia pro/tracking number
+}
+
+//TrackingResponse is data returned from a tracking request
+//handles successful and errors
+type TrackingResponse struct {
+	Code         string
+	Fault        string //S = server, C = client
+	Message      string
+	ProNumber    string
+	Status       string //current status of the shipment
+	DeliveryDate string
+	Events       []TrackingEvent `xml:"Events>Event"`
+}
+
+//TrackingEvent is a single scan/status update in a shipment's history
+type TrackingEvent struct {
+	Date        string
+	Time        string
+	Location    string
+	Description string
+}
+
+//TrackShipment looks up the current status and scan history for a pro number
+func (c *Client) TrackShipment(ctx context.Context, proNumber string) (resp TrackingResponse, err error) {
+	req := TrackingRequest{
+		UserID:        c.UserID,
+		Password:      c.Password,
+		AccountNumber: c.AccountNumber,
+		ProNumber:     proNumber,
+	}
+
+	err = c.do(ctx, trackingURL, trackingSOAPAction, &req, &resp)
+	if err != nil {
+		err = errors.Wrap(err, "saia.TrackShipment - could not track shipment")
+		return
+	}
+
+	if resp.Code != "" {
+		err = errors.New("saia.TrackShipment - tracking request failed")
+		err = errors.Wrap(err, resp.Message)
+		return
+	}
+
+	return
+}
@@ -0,0 +1,131 @@
+package saia
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+//dryRun, set via SetDryRun(), forces TestMode to "Y" on the deprecated
+//(*Request).RequestPickup() so the call still round-trips to SAIA and gets
+//server-side validation, without ever creating a real pickup regardless of
+//SetProductionMode().
+//
+//Deprecated: this is only used by the deprecated (*Request).RequestPickup().
+//Set Client.DryRun (or use WithDryRun()) instead.
+var dryRun = false
+
+//SetDryRun turns dry-run mode on or off.  Use this to exercise RequestPickup
+//end to end (including SAIA's own validation) without risking a real pickup
+//being scheduled.
+//
+//Deprecated: this only affects the deprecated (*Request).RequestPickup();
+//(*Client).RequestPickup() ignores it.  Use WithDryRun() with NewClient() instead.
+func SetDryRun(yes bool) {
+	dryRun = yes
+	return
+}
+
+var (
+	zipcodeRegexp = regexp.MustCompile(`^\d{5}(-\d{4})?$`)
+	twoCharRegexp = regexp.MustCompile(`^[A-Z]{2}$`)
+)
+
+//packageCodes are the shipment package types SAIA accepts
+var packageCodes = map[string]bool{
+	"SK": true, //skids
+	"CT": true, //carton
+	"PL": true, //pallet
+	"BX": true, //box
+	"DR": true, //drum
+	"RL": true, //roll
+	"OT": true, //other
+}
+
+//ValidationError is a single field-level problem found by Validate()
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	return e.Field + " " + e.Message
+}
+
+//ValidationErrors is returned from RequestPickup when Validate() finds
+//problems; it implements error so existing error handling still works, but
+//callers can type assert back to ValidationErrors to show field-level issues.
+type ValidationErrors []ValidationError
+
+func (v ValidationErrors) Error() string {
+	messages := make([]string, len(v))
+	for i, e := range v {
+		messages[i] = e.Error()
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+//Validate performs local field validation on a pickup request: required
+//fields, zipcode format, 24-hour time parsing for ReadyTime/CloseTime, ISO
+//date for PickupDate, two-char state/country codes, and the Package code
+//whitelist.  It does not make any network calls; RequestPickup calls this
+//before submitting so bad requests fail without round-tripping to SAIA.
+func (p *Request) Validate() (errs ValidationErrors) {
+	if p.UserID == "" {
+		errs = append(errs, ValidationError{"UserID", "is required"})
+	}
+	if p.Password == "" {
+		errs = append(errs, ValidationError{"Password", "is required"})
+	}
+	if p.AccountNumber == "" {
+		errs = append(errs, ValidationError{"AccountNumber", "is required"})
+	}
+
+	if p.Item.DestinationZipcode == "" {
+		errs = append(errs, ValidationError{"Item.DestinationZipcode", "is required"})
+	} else if !zipcodeRegexp.MatchString(p.Item.DestinationZipcode) {
+		errs = append(errs, ValidationError{"Item.DestinationZipcode", "must be a 5 digit or zip+4 zipcode"})
+	}
+	if p.Item.Pieces == 0 {
+		errs = append(errs, ValidationError{"Item.Pieces", "must be greater than 0"})
+	}
+	if p.Item.Weight <= 0 {
+		errs = append(errs, ValidationError{"Item.Weight", "must be greater than 0"})
+	}
+	if p.Item.Package == "" {
+		errs = append(errs, ValidationError{"Item.Package", "is required"})
+	} else if !packageCodes[p.Item.Package] {
+		errs = append(errs, ValidationError{"Item.Package", "is not a recognized package code"})
+	}
+	if p.Item.DestinationCountry != "" && !twoCharRegexp.MatchString(p.Item.DestinationCountry) {
+		errs = append(errs, ValidationError{"Item.DestinationCountry", "must be a two character country code"})
+	}
+	if p.Item.Freezable != "" && p.Item.Freezable != "Y" && p.Item.Freezable != "N" {
+		errs = append(errs, ValidationError{"Item.Freezable", "must be Y or N"})
+	}
+
+	if p.Zipcode != "" && !zipcodeRegexp.MatchString(p.Zipcode) {
+		errs = append(errs, ValidationError{"Zipcode", "must be a 5 digit or zip+4 zipcode"})
+	}
+	if p.State != "" && !twoCharRegexp.MatchString(p.State) {
+		errs = append(errs, ValidationError{"State", "must be a two character state code"})
+	}
+	if p.PickupDate != "" {
+		if _, err := time.Parse("2006-01-02", p.PickupDate); err != nil {
+			errs = append(errs, ValidationError{"PickupDate", "must be an ISO date (yyyy-mm-dd)"})
+		}
+	}
+	if p.ReadyTime != "" {
+		if _, err := time.Parse("15:04:05", p.ReadyTime); err != nil {
+			errs = append(errs, ValidationError{"ReadyTime", "must be a 24 hour time (hh:mm:ss)"})
+		}
+	}
+	if p.CloseTime != "" {
+		if _, err := time.Parse("15:04:05", p.CloseTime); err != nil {
+			errs = append(errs, ValidationError{"CloseTime", "must be a 24 hour time (hh:mm:ss)"})
+		}
+	}
+
+	return
+}
@@ -0,0 +1,95 @@
+package saia
+
+import (
+	"testing"
+)
+
+func validRequest() *Request {
+	return &Request{
+		UserID:        "user",
+		Password:      "pass",
+		AccountNumber: "123456",
+		Zipcode:       "70508",
+		State:         "LA",
+		PickupDate:    "2026-07-27",
+		ReadyTime:     "08:00:00",
+		CloseTime:     "17:00:00",
+		Item: Item{
+			DestinationZipcode: "70508-1234",
+			Pieces:             2,
+			Package:            "SK",
+			Weight:             100,
+			DestinationCountry: "US",
+			Freezable:          "N",
+		},
+	}
+}
+
+func TestValidateValidRequest(t *testing.T) {
+	req := validRequest()
+
+	errs := req.Validate()
+	if len(errs) != 0 {
+		t.Fatalf("expected no validation errors, got %v", errs)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		mutate    func(*Request)
+		wantField string
+	}{
+		{"missing UserID", func(r *Request) { r.UserID = "" }, "UserID"},
+		{"missing Password", func(r *Request) { r.Password = "" }, "Password"},
+		{"missing AccountNumber", func(r *Request) { r.AccountNumber = "" }, "AccountNumber"},
+		{"missing DestinationZipcode", func(r *Request) { r.Item.DestinationZipcode = "" }, "Item.DestinationZipcode"},
+		{"malformed DestinationZipcode", func(r *Request) { r.Item.DestinationZipcode = "abc" }, "Item.DestinationZipcode"},
+		{"zero Pieces", func(r *Request) { r.Item.Pieces = 0 }, "Item.Pieces"},
+		{"zero Weight", func(r *Request) { r.Item.Weight = 0 }, "Item.Weight"},
+		{"missing Package", func(r *Request) { r.Item.Package = "" }, "Item.Package"},
+		{"unknown Package", func(r *Request) { r.Item.Package = "ZZ" }, "Item.Package"},
+		{"malformed DestinationCountry", func(r *Request) { r.Item.DestinationCountry = "USA" }, "Item.DestinationCountry"},
+		{"invalid Freezable", func(r *Request) { r.Item.Freezable = "maybe" }, "Item.Freezable"},
+		{"malformed Zipcode", func(r *Request) { r.Zipcode = "abc" }, "Zipcode"},
+		{"malformed State", func(r *Request) { r.State = "Louisiana" }, "State"},
+		{"malformed PickupDate", func(r *Request) { r.PickupDate = "07/27/2026" }, "PickupDate"},
+		{"malformed ReadyTime", func(r *Request) { r.ReadyTime = "8am" }, "ReadyTime"},
+		{"malformed CloseTime", func(r *Request) { r.CloseTime = "5pm" }, "CloseTime"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validRequest()
+			tt.mutate(req)
+
+			errs := req.Validate()
+			if len(errs) == 0 {
+				t.Fatalf("expected a validation error for field %q, got none", tt.wantField)
+			}
+
+			found := false
+			for _, e := range errs {
+				if e.Field == tt.wantField {
+					found = true
+					break
+				}
+			}
+			if !found {
+				t.Fatalf("expected a validation error for field %q, got %v", tt.wantField, errs)
+			}
+		})
+	}
+}
+
+func TestValidationErrorsError(t *testing.T) {
+	errs := ValidationErrors{
+		{Field: "UserID", Message: "is required"},
+		{Field: "Password", Message: "is required"},
+	}
+
+	want := "UserID is required; Password is required"
+	if got := errs.Error(); got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+}